@@ -116,12 +116,297 @@ func (q *Deque[T]) PushBack(values ...T) {
 	q.buf = q.buf[:len(q.buf)+len(values)]
 }
 
+// Insert inserts v at index i, shifting whichever of the two sides of i is
+// shorter. Insert panics if i < 0 or i > q.Len().
+func (q *Deque[T]) Insert(i int, v T) {
+	if !(0 <= i && i <= len(q.buf)) {
+		panic(fmt.Sprintf("index out of range [%d] with length %d", i, len(q.buf)))
+	}
+	q.Grow(1)
+	full := q.buf[:cap(q.buf)]
+	n := len(q.buf)
+	if i < n-i {
+		newHead := q.wrapAdd(q.head, cap(q.buf)-1)
+		copyFwd(full, newHead, q.head, i)
+		full[q.wrapAdd(newHead, i)] = v
+		q.head = newHead
+	} else {
+		copyBack(full, q.toPhysicalIdx(n+1), q.toPhysicalIdx(n), n-i)
+		full[q.toPhysicalIdx(i)] = v
+	}
+	q.buf = q.buf[:n+1]
+}
+
+// Remove removes and returns the item at index i, shifting whichever of the
+// two sides of i is shorter to fill the gap. Remove returns false if i < 0
+// or i >= q.Len().
+func (q *Deque[T]) Remove(i int) (T, bool) {
+	if !(0 <= i && i < len(q.buf)) {
+		var zero T
+		return zero, false
+	}
+	full := q.buf[:cap(q.buf)]
+	n := len(q.buf)
+	removed := full[q.toPhysicalIdx(i)]
+	if i < n-i-1 {
+		copyBack(full, q.toPhysicalIdx(i+1), q.toPhysicalIdx(i), i)
+		q.head = q.toPhysicalIdx(1)
+	} else {
+		copyFwd(full, q.toPhysicalIdx(i), q.toPhysicalIdx(i+1), n-i-1)
+	}
+	q.buf = q.buf[:n-1]
+	return removed, true
+}
+
+// SwapRemoveFront removes and returns the item at index i, replacing it with
+// the front item and then popping the front. This doesn't preserve
+// ordering, but runs in O(1). SwapRemoveFront returns false if i < 0 or
+// i >= q.Len().
+func (q *Deque[T]) SwapRemoveFront(i int) (T, bool) {
+	if !(0 <= i && i < len(q.buf)) {
+		var zero T
+		return zero, false
+	}
+	full := q.buf[:cap(q.buf)]
+	pi := q.toPhysicalIdx(i)
+	removed := full[pi]
+	full[pi] = full[q.head]
+	q.head = q.toPhysicalIdx(1)
+	q.buf = q.buf[:len(q.buf)-1]
+	return removed, true
+}
+
+// SwapRemoveBack removes and returns the item at index i, replacing it with
+// the back item and then popping the back. This doesn't preserve ordering,
+// but runs in O(1). SwapRemoveBack returns false if i < 0 or i >= q.Len().
+func (q *Deque[T]) SwapRemoveBack(i int) (T, bool) {
+	if !(0 <= i && i < len(q.buf)) {
+		var zero T
+		return zero, false
+	}
+	full := q.buf[:cap(q.buf)]
+	pi := q.toPhysicalIdx(i)
+	removed := full[pi]
+	full[pi] = full[q.toPhysicalIdx(len(q.buf)-1)]
+	q.buf = q.buf[:len(q.buf)-1]
+	return removed, true
+}
+
+// copyFwd copies n elements from physical position src to physical position
+// dst, wrapping at cap(full). The source and destination ranges may
+// overlap, but only if dst is reached from src by moving toward the head,
+// i.e. the copy must be safe to perform starting from the low end.
+func copyFwd[T any](full []T, dst, src, n int) {
+	capLen := len(full)
+	for n > 0 {
+		chunk := n
+		if c := capLen - src; c < chunk {
+			chunk = c
+		}
+		if c := capLen - dst; c < chunk {
+			chunk = c
+		}
+		copy(full[dst:dst+chunk], full[src:src+chunk])
+		src += chunk
+		if src == capLen {
+			src = 0
+		}
+		dst += chunk
+		if dst == capLen {
+			dst = 0
+		}
+		n -= chunk
+	}
+}
+
+// copyBack is like copyFwd, but processes the range from the high end, and
+// dstEnd/srcEnd are the (exclusive) physical positions one past the end of
+// the destination/source ranges. It's safe when dst is reached from src by
+// moving toward the tail.
+func copyBack[T any](full []T, dstEnd, srcEnd, n int) {
+	capLen := len(full)
+	for n > 0 {
+		se := srcEnd
+		if se == 0 {
+			se = capLen
+		}
+		de := dstEnd
+		if de == 0 {
+			de = capLen
+		}
+		chunk := n
+		if se < chunk {
+			chunk = se
+		}
+		if de < chunk {
+			chunk = de
+		}
+		copy(full[de-chunk:de], full[se-chunk:se])
+		srcEnd = se - chunk
+		dstEnd = de - chunk
+		n -= chunk
+	}
+}
+
 // Reset empties the deque, retaining the underlying storage for use by
 // future pushes.
 func (q *Deque[T]) Reset() {
 	q.buf = q.buf[:0]
 }
 
+// AsSlices returns the deque's contents as two slices in logical order: the
+// front segment, running from the head to the end of the backing array, and
+// the back segment, running from the start of the backing array up to the
+// tail. If the deque is not wrapped, the back segment is empty. The returned
+// slices alias q's storage and are invalidated by the next call to a method
+// that grows or reallocates the deque.
+func (q *Deque[T]) AsSlices() ([]T, []T) {
+	return q.AsMutSlices()
+}
+
+// AsMutSlices is like [Deque.AsSlices], but the returned slices may be used
+// to modify the deque's elements in place.
+func (q *Deque[T]) AsMutSlices() ([]T, []T) {
+	full := q.buf[:cap(q.buf)]
+	headLen := min(len(q.buf), cap(q.buf)-q.head)
+	front := full[q.head : q.head+headLen : q.head+headLen]
+	back := full[: len(q.buf)-headLen : len(q.buf)-headLen]
+	return front, back
+}
+
+// MakeContiguous rearranges the deque's elements so that they occupy a
+// single contiguous range of the backing array starting at index 0, and
+// returns that range as a slice. If the deque is wrapped and the free
+// capacity is at least the length of the front segment, it shifts the back
+// segment up past the front segment's length and copies the front segment to
+// index 0; otherwise it rotates the whole backing array in place. It does not
+// compare the two segments' lengths to pick the cheaper move.
+func (q *Deque[T]) MakeContiguous() []T {
+	full := q.buf[:cap(q.buf)]
+	headLen := min(len(q.buf), cap(q.buf)-q.head)
+	if headLen == len(q.buf) {
+		// Already contiguous; just slide it down to index 0.
+		copy(full, full[q.head:q.head+headLen])
+		q.head = 0
+		return q.buf
+	}
+	tailLen := len(q.buf) - headLen
+	if free := cap(q.buf) - len(q.buf); free >= headLen {
+		copy(full[headLen:headLen+tailLen], full[:tailLen])
+		copy(full, full[q.head:q.head+headLen])
+	} else {
+		reverse(full[:q.head])
+		reverse(full[q.head:])
+		reverse(full)
+	}
+	q.head = 0
+	return q.buf
+}
+
+func reverse[T any](s []T) {
+	for i, j := 0, len(s)-1; i < j; i, j = i+1, j-1 {
+		s[i], s[j] = s[j], s[i]
+	}
+}
+
+// RotateLeft rotates the deque n places to the left. Equivalently, it moves
+// the item at index n to index 0, preserving order. If the deque's length
+// equals its capacity, this just adjusts the head and runs in O(1); otherwise
+// it stages whichever of the two sides of n is shorter through a small
+// buffer, since that side's source and destination ranges can overlap
+// through the wrap point. RotateLeft panics if n < 0 or n > q.Len().
+func (q *Deque[T]) RotateLeft(n int) {
+	ln := len(q.buf)
+	if !(0 <= n && n <= ln) {
+		panic(fmt.Sprintf("rotation amount %d out of range for length %d", n, ln))
+	}
+	if ln == cap(q.buf) {
+		q.head = q.wrapAdd(q.head, n)
+		return
+	}
+	full := q.buf[:cap(q.buf)]
+	if n <= ln-n {
+		staged := make([]T, n)
+		for k := range staged {
+			staged[k] = full[q.toPhysicalIdx(k)]
+		}
+		q.head = q.toPhysicalIdx(n)
+		for k, v := range staged {
+			full[q.toPhysicalIdx(ln-n+k)] = v
+		}
+	} else {
+		m := ln - n
+		staged := make([]T, m)
+		for k := range staged {
+			staged[k] = full[q.toPhysicalIdx(n+k)]
+		}
+		q.head = q.wrapAdd(q.head, cap(q.buf)-m)
+		for k, v := range staged {
+			full[q.toPhysicalIdx(k)] = v
+		}
+	}
+}
+
+// RotateRight rotates the deque n places to the right. It's equivalent to
+// q.RotateLeft(q.Len() - n). RotateRight panics if n < 0 or n > q.Len().
+func (q *Deque[T]) RotateRight(n int) {
+	ln := len(q.buf)
+	if !(0 <= n && n <= ln) {
+		panic(fmt.Sprintf("rotation amount %d out of range for length %d", n, ln))
+	}
+	q.RotateLeft(ln - n)
+}
+
+// Retain removes every item for which keep returns false, preserving the
+// order of the remaining items.
+func (q *Deque[T]) Retain(keep func(T) bool) {
+	front, back := q.AsMutSlices()
+	w := 0
+	write := func(v T) {
+		if w < len(front) {
+			front[w] = v
+		} else {
+			back[w-len(front)] = v
+		}
+		w++
+	}
+	for _, v := range front {
+		if keep(v) {
+			write(v)
+		}
+	}
+	for _, v := range back {
+		if keep(v) {
+			write(v)
+		}
+	}
+	q.buf = q.buf[:w]
+}
+
+// Truncate shortens the deque to n items by dropping items from the back. It
+// is a no-op if n >= q.Len(). Truncate panics if n < 0.
+func (q *Deque[T]) Truncate(n int) {
+	if n < 0 {
+		panic(fmt.Sprintf("index out of range [%d]", n))
+	}
+	if n >= len(q.buf) {
+		return
+	}
+	full := q.buf[:cap(q.buf)]
+	var zero T
+	for i := n; i < len(q.buf); i++ {
+		full[q.toPhysicalIdx(i)] = zero
+	}
+	q.buf = q.buf[:n]
+}
+
+// Extend appends every item from seq to the back of the deque.
+func (q *Deque[T]) Extend(seq iter.Seq[T]) {
+	for v := range seq {
+		q.PushBack(v)
+	}
+}
+
 // Grow makes space for at least n more elements to be inserted in the given
 // deque without reallocation.
 func (q *Deque[T]) Grow(n int) {
@@ -182,6 +467,44 @@ func (q *Deque[T]) All() iter.Seq2[int, T] {
 	}
 }
 
+// Drain returns an iterator over the elements in [lo, hi). Once the
+// iterator is done, whether it runs to completion or the caller stops
+// early, those elements are removed from the deque, shifting whichever of
+// the two remaining sides is shorter to close the gap. Drain panics if
+// lo or hi is out of range.
+func (q *Deque[T]) Drain(lo, hi int) iter.Seq[T] {
+	if !(0 <= lo && lo <= hi && hi <= len(q.buf)) {
+		panic(fmt.Sprintf("slice bounds out of range [%d:%d] with length %d", lo, hi, len(q.buf)))
+	}
+	return func(yield func(T) bool) {
+		defer q.closeGap(lo, hi)
+		full := q.buf[:cap(q.buf)]
+		for i := lo; i < hi; i++ {
+			if !yield(full[q.toPhysicalIdx(i)]) {
+				return
+			}
+		}
+	}
+}
+
+// closeGap removes the elements in [lo, hi), shifting whichever of the two
+// remaining sides is shorter to close the gap.
+func (q *Deque[T]) closeGap(lo, hi int) {
+	gapLen := hi - lo
+	if gapLen == 0 {
+		return
+	}
+	n := len(q.buf)
+	full := q.buf[:cap(q.buf)]
+	if lo <= n-hi {
+		copyBack(full, q.toPhysicalIdx(hi), q.toPhysicalIdx(lo), lo)
+		q.head = q.toPhysicalIdx(gapLen)
+	} else {
+		copyFwd(full, q.toPhysicalIdx(lo), q.toPhysicalIdx(hi), n-hi)
+	}
+	q.buf = q.buf[:n-gapLen]
+}
+
 // PopAll empties the deque and returns an iterator over the popped elements.
 // It's not safe to modify the deque while iterating using PopAll.
 func (q *Deque[T]) PopAll() iter.Seq[T] {
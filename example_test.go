@@ -2,6 +2,7 @@ package deque_test
 
 import (
 	"fmt"
+	"slices"
 
 	"github.com/rhogenson/deque"
 )
@@ -139,6 +140,161 @@ func ExampleDeque_All() {
 	// 5
 }
 
+func ExampleDeque_Insert() {
+	q := deque.From([]int{2, 3, 4})
+	q.Insert(1, 99)
+	fmt.Println(q)
+
+	// Output:
+	// [2 99 3 4]
+}
+
+func ExampleDeque_Remove() {
+	q := deque.From([]int{1, 2, 3, 4})
+	fmt.Println(q.Remove(1))
+	fmt.Println(q)
+
+	// Output:
+	// 2 true
+	// [1 3 4]
+}
+
+func ExampleDeque_SwapRemoveFront() {
+	q := deque.From([]int{1, 2, 3, 4})
+	fmt.Println(q.SwapRemoveFront(2))
+	fmt.Println(q)
+
+	// Output:
+	// 3 true
+	// [2 1 4]
+}
+
+func ExampleDeque_SwapRemoveBack() {
+	q := deque.From([]int{1, 2, 3, 4})
+	fmt.Println(q.SwapRemoveBack(1))
+	fmt.Println(q)
+
+	// Output:
+	// 2 true
+	// [1 4 3]
+}
+
+func ExampleDeque_AsSlices() {
+	q := deque.From([]int{3, 4, 5})
+	q.PushFront(1, 2)
+	front, back := q.AsSlices()
+	fmt.Println(front, back)
+
+	// Output:
+	// [1 2] [3 4 5]
+}
+
+func ExampleDeque_AsMutSlices() {
+	q := deque.From([]int{3, 4, 5})
+	q.PushFront(1, 2)
+	front, back := q.AsMutSlices()
+	front[0] = 10
+	back[0] = 30
+	fmt.Println(q)
+
+	// Output:
+	// [10 2 30 4 5]
+}
+
+func ExampleDeque_MakeContiguous() {
+	q := deque.From([]int{3, 4, 5})
+	q.PushFront(1, 2)
+	fmt.Println(q.MakeContiguous())
+
+	// Output:
+	// [1 2 3 4 5]
+}
+
+func ExampleDeque_RotateLeft() {
+	q := deque.From([]int{1, 2, 3, 4, 5})
+	q.RotateLeft(2)
+	fmt.Println(q)
+
+	// Output:
+	// [3 4 5 1 2]
+}
+
+func ExampleDeque_RotateLeft_notFull() {
+	// With free capacity, the shorter side being moved can wrap around
+	// through the start of the backing array.
+	q := deque.WithCapacity[int](8)
+	q.PushBack(207, 1, 2, 3, 4, 4, 233)
+	q.RotateLeft(2)
+	fmt.Println(q)
+
+	// Output:
+	// [2 3 4 4 233 207 1]
+}
+
+func ExampleDeque_RotateRight() {
+	q := deque.From([]int{1, 2, 3, 4, 5})
+	q.RotateRight(2)
+	fmt.Println(q)
+
+	// Output:
+	// [4 5 1 2 3]
+}
+
+func ExampleDeque_Retain() {
+	q := deque.From([]int{1, 2, 3, 4, 5, 6})
+	q.Retain(func(v int) bool { return v%2 == 0 })
+	fmt.Println(q)
+
+	// Output:
+	// [2 4 6]
+}
+
+func ExampleDeque_Truncate() {
+	q := deque.From([]int{1, 2, 3, 4, 5})
+	q.Truncate(2)
+	fmt.Println(q)
+
+	// Output:
+	// [1 2]
+}
+
+func ExampleDeque_Extend() {
+	q := deque.From([]int{1, 2, 3})
+	q.Extend(slices.Values([]int{4, 5}))
+	fmt.Println(q)
+
+	// Output:
+	// [1 2 3 4 5]
+}
+
+func ExampleDeque_Drain() {
+	q := deque.From([]int{1, 2, 3, 4, 5})
+	for x := range q.Drain(1, 4) {
+		fmt.Println(x)
+	}
+	fmt.Println(q)
+
+	// Output:
+	// 2
+	// 3
+	// 4
+	// [1 5]
+}
+
+func ExampleDeque_Drain_breakEarly() {
+	q := deque.From([]int{1, 2, 3, 4, 5})
+	for x := range q.Drain(1, 4) {
+		fmt.Println(x)
+		break
+	}
+	// The drained range is removed even though we stopped early.
+	fmt.Println(q)
+
+	// Output:
+	// 2
+	// [1 5]
+}
+
 func ExampleDeque_PopAll() {
 	q := deque.From([]int{1, 2, 3, 4, 5})
 	for x := range q.PopAll() {
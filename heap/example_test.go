@@ -137,6 +137,14 @@ func ExampleNew() {
 	// job1
 }
 
+func ExampleHeapify() {
+	h := heap.Heapify([]int{5, 3, 8, 1, 4}, cmp.Compare[int])
+	fmt.Println(h.Pop())
+
+	// Output:
+	// 1 true
+}
+
 func ExampleHeap_Len() {
 	h := heap.New(cmp.Compare[int])
 	h.Push(1)
@@ -176,3 +184,74 @@ func ExampleHeap_Pop() {
 	// Output:
 	// 1
 }
+
+func ExampleHeap_Peek() {
+	h := heap.New(cmp.Compare[int])
+	h.Push(3)
+	h.Push(1)
+	h.Push(2)
+	fmt.Println(h.Peek())
+
+	// Output:
+	// 1 true
+}
+
+func ExampleHeap_Replace() {
+	h := heap.New(cmp.Compare[int])
+	h.Push(1)
+	h.Push(2)
+	h.Push(3)
+	fmt.Println(h.Replace(0))
+
+	// Output:
+	// 1 true
+}
+
+func ExampleHeap_PushPop() {
+	h := heap.New(cmp.Compare[int])
+	h.Push(2)
+	h.Push(3)
+	fmt.Println(h.PushPop(1))
+
+	// Output:
+	// 1
+}
+
+func ExampleHeap_Fix() {
+	priority := map[string]int{"a": 1, "b": 2}
+	h := heap.New(func(x, y string) int { return cmp.Compare(priority[x], priority[y]) })
+	h.Push("a")
+	h.Push("b")
+	priority["a"] = 5
+	h.Fix(0)
+	fmt.Println(h.Pop())
+
+	// Output:
+	// b true
+}
+
+func ExampleHeap_DrainSorted() {
+	h := heap.New(cmp.Compare[int])
+	h.Push(3)
+	h.Push(1)
+	h.Push(2)
+	for x := range h.DrainSorted() {
+		fmt.Println(x)
+	}
+
+	// Output:
+	// 1
+	// 2
+	// 3
+}
+
+func ExampleHeap_Sorted() {
+	h := heap.New(cmp.Compare[int])
+	h.Push(3)
+	h.Push(1)
+	h.Push(2)
+	fmt.Println(h.Sorted())
+
+	// Output:
+	// [1 2 3]
+}
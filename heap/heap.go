@@ -5,6 +5,7 @@
 package heap
 
 import (
+	"iter"
 	"slices"
 )
 
@@ -19,6 +20,17 @@ func New[T any](compare func(T, T) int) *Heap[T] {
 	return &Heap[T]{compare: compare}
 }
 
+// Heapify creates a new heap using buf as the backing buffer, establishing
+// the heap invariant in place with Floyd's algorithm. This is O(n) where
+// n = len(buf), unlike n calls to [Heap.Push] which would take O(n log n).
+func Heapify[T any](buf []T, compare func(T, T) int) *Heap[T] {
+	h := &Heap[T]{buf: buf, compare: compare}
+	for i := len(buf)/2 - 1; i >= 0; i-- {
+		h.down(i, buf[i])
+	}
+	return h
+}
+
 // Len returns the number of elements in the Heap.
 func (h *Heap[T]) Len() int {
 	return len(h.buf)
@@ -38,6 +50,16 @@ func (h *Heap[T]) Push(x T) {
 	h.up(n)
 }
 
+// Peek returns the minimum element (according to compare) without removing
+// it.
+func (h *Heap[T]) Peek() (T, bool) {
+	if len(h.buf) == 0 {
+		var zero T
+		return zero, false
+	}
+	return h.buf[0], true
+}
+
 // Pop removes and returns the minimum element (according to Less) from
 // the heap. The complexity is O(log n) where n = h.Len().
 func (h *Heap[T]) Pop() (T, bool) {
@@ -54,6 +76,85 @@ func (h *Heap[T]) Pop() (T, bool) {
 	return x, true
 }
 
+// Replace pops the minimum element and pushes x, doing so with a single
+// sift-down instead of a separate Pop and Push. Replace returns false, along
+// with the zero value, if the heap was empty, in which case x is pushed as
+// usual.
+func (h *Heap[T]) Replace(x T) (T, bool) {
+	if len(h.buf) == 0 {
+		h.Push(x)
+		var zero T
+		return zero, false
+	}
+	old := h.buf[0]
+	h.down(0, x)
+	return old, true
+}
+
+// PushPop pushes x onto the heap, then pops and returns the minimum element.
+// If x is itself the minimum, it's returned directly without ever entering
+// the heap, which is cheaper than a Push followed by a Pop.
+func (h *Heap[T]) PushPop(x T) T {
+	if len(h.buf) == 0 || h.compare(x, h.buf[0]) <= 0 {
+		return x
+	}
+	old := h.buf[0]
+	h.down(0, x)
+	return old
+}
+
+// Fix re-establishes the heap invariant after the element at index i has
+// been modified in place, for example because the comparison function
+// depends on external state that changed. The complexity is O(log n) where
+// n = h.Len().
+func (h *Heap[T]) Fix(i int) {
+	if !h.down(i, h.buf[i]) {
+		h.up(i)
+	}
+}
+
+// DrainSorted returns an iterator that pops the heap's elements in ascending
+// order as it goes. If the caller stops early, the elements not yet yielded
+// stay in the heap.
+func (h *Heap[T]) DrainSorted() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for {
+			x, ok := h.Pop()
+			if !ok {
+				return
+			}
+			if !yield(x) {
+				return
+			}
+		}
+	}
+}
+
+// Sorted destructively turns the heap into an ascending slice in O(n log n):
+// it repeatedly pops the minimum into the slot just freed at the end of the
+// backing array, which leaves the array sorted in descending order, then
+// reverses it in place. The returned slice aliases the heap's backing array
+// and is invalidated by the next call to a method that grows the heap.
+func (h *Heap[T]) Sorted() []T {
+	full := h.buf
+	for len(h.buf) > 1 {
+		n := len(h.buf) - 1
+		min, last := h.buf[0], h.buf[n]
+		h.buf[n] = min
+		h.buf = h.buf[:n]
+		h.down(0, last)
+	}
+	reverse(full)
+	h.buf = h.buf[:0]
+	return full
+}
+
+func reverse[T any](s []T) {
+	for i, j := 0, len(s)-1; i < j; i, j = i+1, j-1 {
+		s[i], s[j] = s[j], s[i]
+	}
+}
+
 func (h *Heap[T]) up(j int) {
 	x := h.buf[j]
 	for {
@@ -67,7 +168,10 @@ func (h *Heap[T]) up(j int) {
 	h.buf[j] = x
 }
 
-func (h *Heap[T]) down(i int, x T) {
+// down sifts x down from index i, and reports whether it moved past its
+// starting position.
+func (h *Heap[T]) down(i int, x T) bool {
+	start := i
 	for {
 		j1 := 2*i + 1
 		if j1 >= len(h.buf) || j1 < 0 { // j1 < 0 after int overflow
@@ -84,4 +188,5 @@ func (h *Heap[T]) down(i int, x T) {
 		i = j
 	}
 	h.buf[i] = x
+	return i > start
 }
@@ -2,6 +2,7 @@ package heap
 
 import (
 	"cmp"
+	"slices"
 	"testing"
 )
 
@@ -70,3 +71,136 @@ func TestPopEmpty(t *testing.T) {
 		t.Errorf("Pop() on empty heap = %t, want %t", gotOk, want)
 	}
 }
+
+func TestHeapify(t *testing.T) {
+	t.Parallel()
+
+	h := Heapify([]int{5, 3, 8, 1, 4, 9, 2, 7, 6, 0}, cmp.Compare[int])
+	verify(t, h, 0)
+	var got []int
+	for x, ok := h.Pop(); ok; x, ok = h.Pop() {
+		got = append(got, x)
+	}
+	want := []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}
+	if !slices.Equal(got, want) {
+		t.Errorf("Popped %d, want %d", got, want)
+	}
+}
+
+func TestHeapifyEmpty(t *testing.T) {
+	t.Parallel()
+
+	h := Heapify[int](nil, cmp.Compare[int])
+	if got, want := h.Len(), 0; got != want {
+		t.Errorf("Len() = %d, want %d", got, want)
+	}
+}
+
+func TestPeek(t *testing.T) {
+	t.Parallel()
+
+	h := New(cmp.Compare[int])
+	if _, ok := h.Peek(); ok {
+		t.Error("Peek() on empty heap returned ok = true, want false")
+	}
+	h.Push(5)
+	h.Push(3)
+	h.Push(8)
+	if got, ok := h.Peek(); !ok || got != 3 {
+		t.Errorf("Peek() = %d, %t, want 3, true", got, ok)
+	}
+	if got, want := h.Len(), 3; got != want {
+		t.Errorf("Len() after Peek() = %d, want %d", got, want)
+	}
+}
+
+func TestReplace(t *testing.T) {
+	t.Parallel()
+
+	h := New(cmp.Compare[int])
+	if _, ok := h.Replace(5); ok {
+		t.Error("Replace() on empty heap returned ok = true, want false")
+	}
+	h.Push(3)
+	h.Push(8)
+	verify(t, h, 0)
+	got, ok := h.Replace(1)
+	if !ok || got != 3 {
+		t.Errorf("Replace(1) = %d, %t, want 3, true", got, ok)
+	}
+	verify(t, h, 0)
+	if got, want := h.Len(), 3; got != want {
+		t.Errorf("Len() after Replace(1) = %d, want %d", got, want)
+	}
+	if got, _ := h.Peek(); got != 1 {
+		t.Errorf("Peek() after Replace(1) = %d, want 1", got)
+	}
+}
+
+func TestPushPop(t *testing.T) {
+	t.Parallel()
+
+	h := New(cmp.Compare[int])
+	h.Push(5)
+	h.Push(3)
+	if got, want := h.PushPop(1), 1; got != want {
+		t.Errorf("PushPop(1) = %d, want %d", got, want)
+	}
+	if got, want := h.Len(), 2; got != want {
+		t.Errorf("Len() after PushPop(1) = %d, want %d", got, want)
+	}
+	if got, want := h.PushPop(10), 3; got != want {
+		t.Errorf("PushPop(10) = %d, want %d", got, want)
+	}
+	verify(t, h, 0)
+}
+
+func TestFix(t *testing.T) {
+	t.Parallel()
+
+	h := New(cmp.Compare[int])
+	h.Push(1)
+	h.Push(2)
+	h.Push(3)
+	verify(t, h, 0)
+	h.buf[0] = 10
+	h.Fix(0)
+	verify(t, h, 0)
+}
+
+func TestDrainSorted(t *testing.T) {
+	t.Parallel()
+
+	h := New(cmp.Compare[int])
+	for _, v := range []int{5, 3, 8, 1, 4} {
+		h.Push(v)
+	}
+	var got []int
+	for x := range h.DrainSorted() {
+		got = append(got, x)
+	}
+	want := []int{1, 3, 4, 5, 8}
+	if !slices.Equal(got, want) {
+		t.Errorf("DrainSorted() yielded %d, want %d", got, want)
+	}
+	if got, want := h.Len(), 0; got != want {
+		t.Errorf("Len() after DrainSorted() = %d, want %d", got, want)
+	}
+}
+
+func TestSorted(t *testing.T) {
+	t.Parallel()
+
+	h := New(cmp.Compare[int])
+	for _, v := range []int{5, 3, 8, 1, 4} {
+		h.Push(v)
+	}
+	got := h.Sorted()
+	want := []int{1, 3, 4, 5, 8}
+	if !slices.Equal(got, want) {
+		t.Errorf("Sorted() = %d, want %d", got, want)
+	}
+	if got, want := h.Len(), 0; got != want {
+		t.Errorf("Len() after Sorted() = %d, want %d", got, want)
+	}
+}
@@ -0,0 +1,489 @@
+// Package vecdeque implements a double-ended queue (deque) implemented with a
+// growable ring buffer.
+//
+// This queue has O(1) amortized inserts and removals from both ends of the
+// container. It also has O(1) indexing like a vector.
+package vecdeque
+
+// DQ is a double-ended queue. The zero value is ready for use.
+type DQ[T any] struct {
+	head int
+	buf  []T
+}
+
+// WithCapacity allocates a deque with the given capacity.
+func WithCapacity[T any](cap int) *DQ[T] {
+	return &DQ[T]{buf: make([]T, 0, cap)}
+}
+
+// From creates a new queue using the given slice as the backing buffer.
+func From[S ~[]T, T any](slice S) *DQ[T] {
+	return &DQ[T]{buf: slice}
+}
+
+func (q *DQ[T]) wrapAdd(i, addend int) int {
+	i += addend
+	if i >= cap(q.buf) {
+		return i - cap(q.buf)
+	}
+	return i
+}
+
+func (q *DQ[T]) toPhysicalIdx(i int) int {
+	return q.wrapAdd(q.head, i)
+}
+
+// Get returns the item at position i.
+func (q *DQ[T]) Get(i int) T {
+	return q.buf[:cap(q.buf)][q.toPhysicalIdx(i)]
+}
+
+// Cap returns the number of elements the deque can hold without reallocating.
+func (q *DQ[T]) Cap() int {
+	return cap(q.buf)
+}
+
+// Len returns the number of elements in the deque.
+func (q *DQ[T]) Len() int {
+	return len(q.buf)
+}
+
+// PopFront removes and returns the item at index 0 if the deque is non-empty.
+func (q *DQ[T]) PopFront() (T, bool) {
+	if len(q.buf) == 0 {
+		var zero T
+		return zero, false
+	}
+	oldHead := q.head
+	q.head = q.toPhysicalIdx(1)
+	q.buf = q.buf[:len(q.buf)-1]
+	return q.buf[:cap(q.buf)][oldHead], true
+}
+
+// PopBack removes and returns the last item in the deque if it is non-empty.
+func (q *DQ[T]) PopBack() (T, bool) {
+	if len(q.buf) == 0 {
+		var zero T
+		return zero, false
+	}
+	q.buf = q.buf[:len(q.buf)-1]
+	return q.Get(len(q.buf)), true
+}
+
+// PushFront prepends the given items to the front of the deque.
+func (q *DQ[T]) PushFront(values ...T) {
+	q.Grow(len(values))
+	q.buf = q.buf[:len(q.buf)+len(values)]
+	if q.head >= len(values) {
+		newHead := q.head - len(values)
+		copy(q.buf[newHead:q.head], values)
+		q.head = newHead
+	} else {
+		tailLen := len(values) - q.head
+		copy(q.buf[:q.head], values[tailLen:])
+		copy(q.buf[cap(q.buf)-tailLen:cap(q.buf)], values[:tailLen])
+		q.head = cap(q.buf) - tailLen
+	}
+}
+
+// Insert inserts v at index i, shifting whichever of the two sides of i is
+// shorter. Insert panics if i < 0 or i > q.Len().
+func (q *DQ[T]) Insert(i int, v T) {
+	if !(0 <= i && i <= len(q.buf)) {
+		panic("vecdeque: index out of range")
+	}
+	q.Grow(1)
+	full := q.buf[:cap(q.buf)]
+	n := len(q.buf)
+	if i < n-i {
+		newHead := q.wrapAdd(q.head, cap(q.buf)-1)
+		copyFwd(full, newHead, q.head, i)
+		full[q.wrapAdd(newHead, i)] = v
+		q.head = newHead
+	} else {
+		copyBack(full, q.toPhysicalIdx(n+1), q.toPhysicalIdx(n), n-i)
+		full[q.toPhysicalIdx(i)] = v
+	}
+	q.buf = q.buf[:n+1]
+}
+
+// Remove removes and returns the item at index i, shifting whichever of the
+// two sides of i is shorter to fill the gap. Remove returns false if i < 0
+// or i >= q.Len().
+func (q *DQ[T]) Remove(i int) (T, bool) {
+	if !(0 <= i && i < len(q.buf)) {
+		var zero T
+		return zero, false
+	}
+	full := q.buf[:cap(q.buf)]
+	n := len(q.buf)
+	removed := full[q.toPhysicalIdx(i)]
+	if i < n-i-1 {
+		copyBack(full, q.toPhysicalIdx(i+1), q.toPhysicalIdx(i), i)
+		q.head = q.toPhysicalIdx(1)
+	} else {
+		copyFwd(full, q.toPhysicalIdx(i), q.toPhysicalIdx(i+1), n-i-1)
+	}
+	q.buf = q.buf[:n-1]
+	return removed, true
+}
+
+// SwapRemoveFront removes and returns the item at index i, replacing it with
+// the front item and then popping the front. This doesn't preserve
+// ordering, but runs in O(1). SwapRemoveFront returns false if i < 0 or
+// i >= q.Len().
+func (q *DQ[T]) SwapRemoveFront(i int) (T, bool) {
+	if !(0 <= i && i < len(q.buf)) {
+		var zero T
+		return zero, false
+	}
+	full := q.buf[:cap(q.buf)]
+	pi := q.toPhysicalIdx(i)
+	removed := full[pi]
+	full[pi] = full[q.head]
+	q.head = q.toPhysicalIdx(1)
+	q.buf = q.buf[:len(q.buf)-1]
+	return removed, true
+}
+
+// SwapRemoveBack removes and returns the item at index i, replacing it with
+// the back item and then popping the back. This doesn't preserve ordering,
+// but runs in O(1). SwapRemoveBack returns false if i < 0 or i >= q.Len().
+func (q *DQ[T]) SwapRemoveBack(i int) (T, bool) {
+	if !(0 <= i && i < len(q.buf)) {
+		var zero T
+		return zero, false
+	}
+	full := q.buf[:cap(q.buf)]
+	pi := q.toPhysicalIdx(i)
+	removed := full[pi]
+	full[pi] = full[q.toPhysicalIdx(len(q.buf)-1)]
+	q.buf = q.buf[:len(q.buf)-1]
+	return removed, true
+}
+
+// copyFwd copies n elements from physical position src to physical position
+// dst, wrapping at cap(full). The source and destination ranges may
+// overlap, but only if dst is reached from src by moving toward the head,
+// i.e. the copy must be safe to perform starting from the low end.
+func copyFwd[T any](full []T, dst, src, n int) {
+	capLen := len(full)
+	for n > 0 {
+		chunk := n
+		if c := capLen - src; c < chunk {
+			chunk = c
+		}
+		if c := capLen - dst; c < chunk {
+			chunk = c
+		}
+		copy(full[dst:dst+chunk], full[src:src+chunk])
+		src += chunk
+		if src == capLen {
+			src = 0
+		}
+		dst += chunk
+		if dst == capLen {
+			dst = 0
+		}
+		n -= chunk
+	}
+}
+
+// copyBack is like copyFwd, but processes the range from the high end, and
+// dstEnd/srcEnd are the (exclusive) physical positions one past the end of
+// the destination/source ranges. It's safe when dst is reached from src by
+// moving toward the tail.
+func copyBack[T any](full []T, dstEnd, srcEnd, n int) {
+	capLen := len(full)
+	for n > 0 {
+		se := srcEnd
+		if se == 0 {
+			se = capLen
+		}
+		de := dstEnd
+		if de == 0 {
+			de = capLen
+		}
+		chunk := n
+		if se < chunk {
+			chunk = se
+		}
+		if de < chunk {
+			chunk = de
+		}
+		copy(full[de-chunk:de], full[se-chunk:se])
+		srcEnd = se - chunk
+		dstEnd = de - chunk
+		n -= chunk
+	}
+}
+
+// AsSlices returns the deque's contents as two slices in logical order: the
+// front segment, running from the head to the end of the backing array, and
+// the back segment, running from the start of the backing array up to the
+// tail. If the deque is not wrapped, the back segment is empty.
+func (q *DQ[T]) AsSlices() ([]T, []T) {
+	return q.AsMutSlices()
+}
+
+// AsMutSlices is like [DQ.AsSlices], but the returned slices may be used to
+// modify the deque's elements in place.
+func (q *DQ[T]) AsMutSlices() ([]T, []T) {
+	full := q.buf[:cap(q.buf)]
+	headLen := min(len(q.buf), cap(q.buf)-q.head)
+	front := full[q.head : q.head+headLen : q.head+headLen]
+	back := full[: len(q.buf)-headLen : len(q.buf)-headLen]
+	return front, back
+}
+
+// MakeContiguous rearranges the deque's elements so that they occupy a
+// single contiguous range of the backing array starting at index 0, and
+// returns that range as a slice.
+func (q *DQ[T]) MakeContiguous() []T {
+	full := q.buf[:cap(q.buf)]
+	headLen := min(len(q.buf), cap(q.buf)-q.head)
+	if headLen == len(q.buf) {
+		// Already contiguous; just slide it down to index 0.
+		copy(full, full[q.head:q.head+headLen])
+		q.head = 0
+		return q.buf
+	}
+	tailLen := len(q.buf) - headLen
+	if free := cap(q.buf) - len(q.buf); free >= headLen {
+		copy(full[headLen:headLen+tailLen], full[:tailLen])
+		copy(full, full[q.head:q.head+headLen])
+	} else {
+		reverse(full[:q.head])
+		reverse(full[q.head:])
+		reverse(full)
+	}
+	q.head = 0
+	return q.buf
+}
+
+func reverse[T any](s []T) {
+	for i, j := 0, len(s)-1; i < j; i, j = i+1, j-1 {
+		s[i], s[j] = s[j], s[i]
+	}
+}
+
+// RotateLeft rotates the deque n places to the left. Equivalently, it moves
+// the item at index n to index 0, preserving order. If the deque's length
+// equals its capacity, this just adjusts the head and runs in O(1); otherwise
+// it stages whichever of the two sides of n is shorter through a small
+// buffer, since that side's source and destination ranges can overlap
+// through the wrap point.
+func (q *DQ[T]) RotateLeft(n int) {
+	ln := len(q.buf)
+	if !(0 <= n && n <= ln) {
+		panic("vecdeque: rotation amount out of range")
+	}
+	if ln == cap(q.buf) {
+		q.head = q.wrapAdd(q.head, n)
+		return
+	}
+	full := q.buf[:cap(q.buf)]
+	if n <= ln-n {
+		staged := make([]T, n)
+		for k := range staged {
+			staged[k] = full[q.toPhysicalIdx(k)]
+		}
+		q.head = q.toPhysicalIdx(n)
+		for k, v := range staged {
+			full[q.toPhysicalIdx(ln-n+k)] = v
+		}
+	} else {
+		m := ln - n
+		staged := make([]T, m)
+		for k := range staged {
+			staged[k] = full[q.toPhysicalIdx(n+k)]
+		}
+		q.head = q.wrapAdd(q.head, cap(q.buf)-m)
+		for k, v := range staged {
+			full[q.toPhysicalIdx(k)] = v
+		}
+	}
+}
+
+// RotateRight rotates the deque n places to the right. It's equivalent to
+// q.RotateLeft(q.Len() - n).
+func (q *DQ[T]) RotateRight(n int) {
+	ln := len(q.buf)
+	if !(0 <= n && n <= ln) {
+		panic("vecdeque: rotation amount out of range")
+	}
+	q.RotateLeft(ln - n)
+}
+
+// Retain removes every item for which keep returns false, preserving the
+// order of the remaining items.
+func (q *DQ[T]) Retain(keep func(T) bool) {
+	front, back := q.AsMutSlices()
+	w := 0
+	write := func(v T) {
+		if w < len(front) {
+			front[w] = v
+		} else {
+			back[w-len(front)] = v
+		}
+		w++
+	}
+	for _, v := range front {
+		if keep(v) {
+			write(v)
+		}
+	}
+	for _, v := range back {
+		if keep(v) {
+			write(v)
+		}
+	}
+	q.buf = q.buf[:w]
+}
+
+// Truncate shortens the deque to n items by dropping items from the back. It
+// is a no-op if n >= q.Len().
+func (q *DQ[T]) Truncate(n int) {
+	if n < 0 {
+		panic("vecdeque: index out of range")
+	}
+	if n >= len(q.buf) {
+		return
+	}
+	full := q.buf[:cap(q.buf)]
+	var zero T
+	for i := n; i < len(q.buf); i++ {
+		full[q.toPhysicalIdx(i)] = zero
+	}
+	q.buf = q.buf[:n]
+}
+
+// Extend appends every item from seq to the back of the deque.
+func (q *DQ[T]) Extend(seq func(func(T) bool)) {
+	for v := range seq {
+		q.PushBack(v)
+	}
+}
+
+// PushBack appends the given items to the back of the deque.
+func (q *DQ[T]) PushBack(values ...T) {
+	q.Grow(len(values))
+	endIdx := q.wrapAdd(q.head, len(q.buf))
+	if len(values) <= cap(q.buf)-endIdx {
+		copy(q.buf[endIdx:endIdx+len(values)], values)
+	} else {
+		headLen := cap(q.buf) - endIdx
+		copy(q.buf[endIdx:cap(q.buf)], values[:headLen])
+		copy(q.buf[:len(values)-headLen], values[headLen:])
+	}
+	q.buf = q.buf[:len(q.buf)+len(values)]
+}
+
+// Grow makes space for at least n more elements to be inserted in the given
+// deque without reallocation.
+func (q *DQ[T]) Grow(n int) {
+	n -= cap(q.buf) - len(q.buf)
+	if n <= 0 {
+		return
+	}
+
+	oldCap := cap(q.buf)
+	q.buf = append(q.buf[:cap(q.buf)], make([]T, n)...)[:len(q.buf)]
+	newCap := cap(q.buf)
+
+	// Move the shortest contiguous section of the ring buffer
+	//
+	// H := head
+	// L := last element (`self.to_physical_idx(self.len - 1)`)
+	//
+	//    H             L
+	//   [o o o o o o o o ]
+	//    H             L
+	// A [o o o o o o o o . . . . . . . . ]
+	//        L H
+	//   [o o o o o o o o ]
+	//          H             L
+	// B [. . . o o o o o o o o . . . . . ]
+	//              L H
+	//   [o o o o o o o o ]
+	//              L                 H
+	// C [o o o o o o . . . . . . . . o o ]
+
+	if q.head <= oldCap-len(q.buf) {
+		// A
+		return
+	}
+	headLen := oldCap - q.head
+	tailLen := len(q.buf) - headLen
+	if headLen > tailLen && newCap-oldCap >= tailLen {
+		// B
+		copy(q.buf[oldCap:oldCap+tailLen], q.buf[:tailLen])
+		return
+	}
+	// C
+	newHead := newCap - headLen
+	copy(q.buf[newHead:newHead+headLen], q.buf[q.head:q.head+headLen])
+	q.head = newHead
+}
+
+// All returns an iterator over the elements in the deque. It does not pop
+// any elements.
+func (q *DQ[T]) All() func(func(int, T) bool) {
+	return func(yield func(int, T) bool) {
+		for i := range q.Len() {
+			if !yield(i, q.Get(i)) {
+				return
+			}
+		}
+	}
+}
+
+// Drain returns an iterator over the elements in [lo, hi). Once the
+// iterator is done, whether it runs to completion or the caller stops
+// early, those elements are removed from the deque, shifting whichever of
+// the two remaining sides is shorter to close the gap. Drain panics if lo
+// or hi is out of range.
+func (q *DQ[T]) Drain(lo, hi int) func(func(T) bool) {
+	if !(0 <= lo && lo <= hi && hi <= len(q.buf)) {
+		panic("vecdeque: slice bounds out of range")
+	}
+	return func(yield func(T) bool) {
+		defer q.closeGap(lo, hi)
+		full := q.buf[:cap(q.buf)]
+		for i := lo; i < hi; i++ {
+			if !yield(full[q.toPhysicalIdx(i)]) {
+				return
+			}
+		}
+	}
+}
+
+// closeGap removes the elements in [lo, hi), shifting whichever of the two
+// remaining sides is shorter to close the gap.
+func (q *DQ[T]) closeGap(lo, hi int) {
+	gapLen := hi - lo
+	if gapLen == 0 {
+		return
+	}
+	n := len(q.buf)
+	full := q.buf[:cap(q.buf)]
+	if lo <= n-hi {
+		copyBack(full, q.toPhysicalIdx(hi), q.toPhysicalIdx(lo), lo)
+		q.head = q.toPhysicalIdx(gapLen)
+	} else {
+		copyFwd(full, q.toPhysicalIdx(lo), q.toPhysicalIdx(hi), n-hi)
+	}
+	q.buf = q.buf[:n-gapLen]
+}
+
+// PopAll returns an iterator that consumes all the values in the deque, leaving
+// it empty.
+func (q *DQ[T]) PopAll() func(func(T) bool) {
+	return func(yield func(T) bool) {
+		for val, ok := q.PopFront(); ok; val, ok = q.PopFront() {
+			if !yield(val) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,795 @@
+package vecdeque
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestWithCapacity(t *testing.T) {
+	t.Parallel()
+
+	const cap = 10
+	q := WithCapacity[int](cap)
+	for i := range cap {
+		q.PushBack(i)
+	}
+	if got := q.Cap(); got != cap {
+		t.Errorf("Cap() = %d, want %d", got, cap)
+	}
+}
+
+func TestGet(t *testing.T) {
+	t.Parallel()
+
+	q := new(DQ[int])
+	for i := range 10 {
+		q.PushBack(i)
+	}
+	for i := range 3 {
+		if got := q.Get(i); got != i {
+			t.Errorf("Get(%d) = %d, want %d", i, got, i)
+		}
+	}
+}
+
+func TestPopFront(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		desc         string
+		in           []int
+		wantOk       bool
+		wantVal      int
+		wantContents []int
+	}{{
+		desc:         "PopVal",
+		in:           []int{1, 2, 3},
+		wantOk:       true,
+		wantVal:      1,
+		wantContents: []int{2, 3},
+	}, {
+		desc:   "PopNone",
+		in:     nil,
+		wantOk: false,
+	}} {
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			q := From(tc.in)
+			got, ok := q.PopFront()
+			if ok != tc.wantOk {
+				t.Errorf("%d: PopFront() returned ok = %t, want %t", tc.in, ok, tc.wantOk)
+			}
+			if got != tc.wantVal {
+				t.Errorf("%d: PopFront() = %d, want %d", tc.in, got, tc.wantVal)
+			}
+			gotContents := make([]int, q.Len())
+			for i, x := range q.All() {
+				gotContents[i] = x
+			}
+			if !slices.Equal(gotContents, tc.wantContents) {
+				t.Errorf("%d: Contents after PopFront are %d, want %d", tc.in, gotContents, tc.wantContents)
+			}
+		})
+	}
+}
+
+func TestPopBack(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		desc         string
+		in           []int
+		wantOk       bool
+		wantVal      int
+		wantContents []int
+	}{{
+		desc:         "PopVal",
+		in:           []int{1, 2, 3},
+		wantOk:       true,
+		wantVal:      3,
+		wantContents: []int{1, 2},
+	}, {
+		desc:   "PopNone",
+		in:     nil,
+		wantOk: false,
+	}} {
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			q := From(tc.in)
+			got, ok := q.PopBack()
+			if ok != tc.wantOk {
+				t.Errorf("%d: PopBack() returned ok = %t, want %t", tc.in, ok, tc.wantOk)
+			}
+			if got != tc.wantVal {
+				t.Errorf("%d: PopBack() = %d, want %d", tc.in, got, tc.wantVal)
+			}
+			gotContents := make([]int, q.Len())
+			for i, x := range q.All() {
+				gotContents[i] = x
+			}
+			if !slices.Equal(gotContents, tc.wantContents) {
+				t.Errorf("%d: Contents after PopBack are %d, want %d", tc.in, gotContents, tc.wantContents)
+			}
+		})
+	}
+}
+
+func TestPushFront(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		desc        string
+		prevContent []int
+		push        []int
+		want        []int
+	}{{
+		desc:        "PushNil",
+		prevContent: nil,
+		push:        []int{1},
+		want:        []int{1},
+	}, {
+		desc:        "PushExisting",
+		prevContent: []int{1, 2, 3},
+		push:        []int{4, 5, 6},
+		want:        []int{4, 5, 6, 1, 2, 3},
+	}} {
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			q := From(tc.prevContent)
+			q.PushFront(tc.push...)
+			got := make([]int, q.Len())
+			for i, x := range q.All() {
+				got[i] = x
+			}
+			if !slices.Equal(got, tc.want) {
+				t.Errorf("%d: PushFront(%d) = %d, want %d", tc.prevContent, tc.push, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestPushBack(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		desc        string
+		prevContent []int
+		push        []int
+		want        []int
+	}{{
+		desc:        "PushNil",
+		prevContent: nil,
+		push:        []int{1},
+		want:        []int{1},
+	}, {
+		desc:        "PushExisting",
+		prevContent: []int{1, 2, 3},
+		push:        []int{4, 5, 6},
+		want:        []int{1, 2, 3, 4, 5, 6},
+	}} {
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			q := From(tc.prevContent)
+			q.PushBack(tc.push...)
+			got := make([]int, q.Len())
+			for i, x := range q.All() {
+				got[i] = x
+			}
+			if !slices.Equal(got, tc.want) {
+				t.Errorf("%d: PushBack(%d) = %d, want %d", tc.prevContent, tc.push, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestPopFrontPushBackB(t *testing.T) {
+	t.Parallel()
+
+	q := From([]int{1, 2, 3})
+	q.PopFront()
+	q.PushBack(4)
+	q.PushBack(5)
+	got := make([]int, q.Len())
+	for i, x := range q.All() {
+		got[i] = x
+	}
+	want := []int{2, 3, 4, 5}
+	if !slices.Equal(got, want) {
+		t.Errorf("Contents = %d, want %d", got, want)
+	}
+}
+
+func TestPopFrontPushBackC(t *testing.T) {
+	t.Parallel()
+
+	q := From([]int{1, 2, 3})
+	q.PopFront()
+	q.PopFront()
+	q.PushBack(4)
+	q.PushBack(5)
+	q.PushBack(6)
+	got := make([]int, q.Len())
+	for i, x := range q.All() {
+		got[i] = x
+	}
+	want := []int{3, 4, 5, 6}
+	if !slices.Equal(got, want) {
+		t.Errorf("Contents = %d, want %d", got, want)
+	}
+}
+
+func TestPopFrontPushFront(t *testing.T) {
+	t.Parallel()
+
+	q := From([]int{1, 2, 3})
+	q.PopFront()
+	q.PopFront()
+	q.PushFront(4, 5)
+	if got, want := q.Cap(), 3; got != want {
+		t.Errorf("Cap() = %d, want %d", got, want)
+	}
+	got := make([]int, q.Len())
+	for i, x := range q.All() {
+		got[i] = x
+	}
+	want := []int{4, 5, 3}
+	if !slices.Equal(got, want) {
+		t.Errorf("Contents = %d, want %d", got, want)
+	}
+}
+
+func TestInsert(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		desc string
+		in   []int
+		i    int
+		v    int
+		want []int
+	}{{
+		desc: "Front",
+		in:   []int{2, 3, 4},
+		i:    0,
+		v:    1,
+		want: []int{1, 2, 3, 4},
+	}, {
+		desc: "Back",
+		in:   []int{1, 2, 3},
+		i:    3,
+		v:    4,
+		want: []int{1, 2, 3, 4},
+	}, {
+		desc: "Middle",
+		in:   []int{2, 3, 4},
+		i:    1,
+		v:    99,
+		want: []int{2, 99, 3, 4},
+	}} {
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			q := From(tc.in)
+			q.Insert(tc.i, tc.v)
+			got := make([]int, q.Len())
+			for i, x := range q.All() {
+				got[i] = x
+			}
+			if !slices.Equal(got, tc.want) {
+				t.Errorf("Insert(%d, %d) contents = %d, want %d", tc.i, tc.v, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestInsertWrapped(t *testing.T) {
+	t.Parallel()
+
+	q := From([]int{1, 2, 3})
+	q.PopFront()
+	q.PopFront()
+	q.PushBack(4)
+	q.Insert(1, 99)
+	got := make([]int, q.Len())
+	for i, x := range q.All() {
+		got[i] = x
+	}
+	want := []int{3, 99, 4}
+	if !slices.Equal(got, want) {
+		t.Errorf("Contents = %d, want %d", got, want)
+	}
+}
+
+func TestRemove(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		desc         string
+		in           []int
+		i            int
+		wantVal      int
+		wantContents []int
+	}{{
+		desc:         "Front",
+		in:           []int{1, 2, 3, 4},
+		i:            0,
+		wantVal:      1,
+		wantContents: []int{2, 3, 4},
+	}, {
+		desc:         "Back",
+		in:           []int{1, 2, 3, 4},
+		i:            3,
+		wantVal:      4,
+		wantContents: []int{1, 2, 3},
+	}, {
+		desc:         "Middle",
+		in:           []int{1, 2, 3, 4},
+		i:            1,
+		wantVal:      2,
+		wantContents: []int{1, 3, 4},
+	}} {
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			q := From(tc.in)
+			got, ok := q.Remove(tc.i)
+			if !ok || got != tc.wantVal {
+				t.Errorf("Remove(%d) = %d, %t, want %d, true", tc.i, got, ok, tc.wantVal)
+			}
+			gotContents := make([]int, q.Len())
+			for i, x := range q.All() {
+				gotContents[i] = x
+			}
+			if !slices.Equal(gotContents, tc.wantContents) {
+				t.Errorf("Contents after Remove(%d) = %d, want %d", tc.i, gotContents, tc.wantContents)
+			}
+		})
+	}
+}
+
+func TestRemoveOutOfRange(t *testing.T) {
+	t.Parallel()
+
+	q := From([]int{1, 2, 3})
+	if _, ok := q.Remove(3); ok {
+		t.Error("Remove(3) returned ok = true, want false")
+	}
+	if _, ok := q.Remove(-1); ok {
+		t.Error("Remove(-1) returned ok = true, want false")
+	}
+}
+
+func TestRemoveWrapped(t *testing.T) {
+	t.Parallel()
+
+	q := From([]int{1, 2, 3})
+	q.PopFront()
+	q.PopFront()
+	q.PushBack(4)
+	q.PushBack(5)
+	got, ok := q.Remove(1)
+	if !ok || got != 4 {
+		t.Errorf("Remove(1) = %d, %t, want 4, true", got, ok)
+	}
+	gotContents := make([]int, q.Len())
+	for i, x := range q.All() {
+		gotContents[i] = x
+	}
+	want := []int{3, 5}
+	if !slices.Equal(gotContents, want) {
+		t.Errorf("Contents = %d, want %d", gotContents, want)
+	}
+}
+
+func TestSwapRemoveFront(t *testing.T) {
+	t.Parallel()
+
+	q := From([]int{1, 2, 3, 4})
+	got, ok := q.SwapRemoveFront(2)
+	if !ok || got != 3 {
+		t.Errorf("SwapRemoveFront(2) = %d, %t, want 3, true", got, ok)
+	}
+	gotContents := make([]int, q.Len())
+	for i, x := range q.All() {
+		gotContents[i] = x
+	}
+	want := []int{2, 1, 4}
+	if !slices.Equal(gotContents, want) {
+		t.Errorf("Contents = %d, want %d", gotContents, want)
+	}
+}
+
+func TestSwapRemoveBack(t *testing.T) {
+	t.Parallel()
+
+	q := From([]int{1, 2, 3, 4})
+	got, ok := q.SwapRemoveBack(1)
+	if !ok || got != 2 {
+		t.Errorf("SwapRemoveBack(1) = %d, %t, want 2, true", got, ok)
+	}
+	gotContents := make([]int, q.Len())
+	for i, x := range q.All() {
+		gotContents[i] = x
+	}
+	want := []int{1, 4, 3}
+	if !slices.Equal(gotContents, want) {
+		t.Errorf("Contents = %d, want %d", gotContents, want)
+	}
+}
+
+func TestDrain(t *testing.T) {
+	t.Parallel()
+
+	q := From([]int{1, 2, 3, 4, 5})
+	var got []int
+	for x := range q.Drain(1, 4) {
+		got = append(got, x)
+	}
+	want := []int{2, 3, 4}
+	if !slices.Equal(got, want) {
+		t.Errorf("Drain(1, 4) yielded %d, want %d", got, want)
+	}
+	gotContents := make([]int, q.Len())
+	for i, x := range q.All() {
+		gotContents[i] = x
+	}
+	wantContents := []int{1, 5}
+	if !slices.Equal(gotContents, wantContents) {
+		t.Errorf("Contents after Drain(1, 4) = %d, want %d", gotContents, wantContents)
+	}
+}
+
+func TestDrainBreak(t *testing.T) {
+	t.Parallel()
+
+	q := From([]int{1, 2, 3, 4, 5})
+	for range q.Drain(1, 4) {
+		break
+	}
+	got := make([]int, q.Len())
+	for i, x := range q.All() {
+		got[i] = x
+	}
+	want := []int{1, 5}
+	if !slices.Equal(got, want) {
+		t.Errorf("Contents after breaking out of Drain(1, 4) = %d, want %d", got, want)
+	}
+}
+
+func TestDrainPrefix(t *testing.T) {
+	t.Parallel()
+
+	q := From([]int{1, 2, 3, 4, 5})
+	for range q.Drain(0, 2) {
+	}
+	got := make([]int, q.Len())
+	for i, x := range q.All() {
+		got[i] = x
+	}
+	want := []int{3, 4, 5}
+	if !slices.Equal(got, want) {
+		t.Errorf("Contents after Drain(0, 2) = %d, want %d", got, want)
+	}
+}
+
+func TestDrainWrapped(t *testing.T) {
+	t.Parallel()
+
+	q := From([]int{1, 2, 3})
+	q.PopFront()
+	q.PopFront()
+	q.PushBack(4)
+	q.PushBack(5)
+	q.PushBack(6)
+	var got []int
+	for x := range q.Drain(1, 3) {
+		got = append(got, x)
+	}
+	want := []int{4, 5}
+	if !slices.Equal(got, want) {
+		t.Errorf("Drain(1, 3) yielded %d, want %d", got, want)
+	}
+	gotContents := make([]int, q.Len())
+	for i, x := range q.All() {
+		gotContents[i] = x
+	}
+	wantContents := []int{3, 6}
+	if !slices.Equal(gotContents, wantContents) {
+		t.Errorf("Contents after Drain(1, 3) = %d, want %d", gotContents, wantContents)
+	}
+}
+
+func TestRotateLeft(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		desc string
+		in   []int
+		n    int
+		want []int
+	}{{
+		desc: "Basic",
+		in:   []int{1, 2, 3, 4, 5},
+		n:    2,
+		want: []int{3, 4, 5, 1, 2},
+	}, {
+		desc: "Zero",
+		in:   []int{1, 2, 3},
+		n:    0,
+		want: []int{1, 2, 3},
+	}, {
+		desc: "Full",
+		in:   []int{1, 2, 3},
+		n:    3,
+		want: []int{1, 2, 3},
+	}} {
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			q := From(tc.in)
+			q.RotateLeft(tc.n)
+			got := make([]int, q.Len())
+			for i, x := range q.All() {
+				got[i] = x
+			}
+			if !slices.Equal(got, tc.want) {
+				t.Errorf("RotateLeft(%d) contents = %d, want %d", tc.n, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestRotateLeftFull(t *testing.T) {
+	t.Parallel()
+
+	q := From([]int{1, 2, 3})
+	q.PopFront()
+	q.PopFront()
+	q.PushBack(4)
+	q.PushBack(5)
+	q.RotateLeft(1)
+	got := make([]int, q.Len())
+	for i, x := range q.All() {
+		got[i] = x
+	}
+	want := []int{4, 5, 3}
+	if !slices.Equal(got, want) {
+		t.Errorf("Contents after RotateLeft(1) = %d, want %d", got, want)
+	}
+}
+
+func TestRotateLeftWrapped(t *testing.T) {
+	t.Parallel()
+
+	q := WithCapacity[int](5)
+	q.PushBack(1, 2, 3, 4, 5)
+	q.PopFront()
+	q.PopFront()
+	q.RotateLeft(1)
+	got := make([]int, q.Len())
+	for i, x := range q.All() {
+		got[i] = x
+	}
+	want := []int{4, 5, 3}
+	if !slices.Equal(got, want) {
+		t.Errorf("Contents after RotateLeft(1) = %d, want %d", got, want)
+	}
+}
+
+func TestRotateLeftWrappedBackShift(t *testing.T) {
+	t.Parallel()
+
+	q := WithCapacity[int](5)
+	q.PushBack(1, 2, 3, 4, 5)
+	q.PopFront()
+	q.PopFront()
+	q.RotateLeft(2)
+	got := make([]int, q.Len())
+	for i, x := range q.All() {
+		got[i] = x
+	}
+	want := []int{5, 3, 4}
+	if !slices.Equal(got, want) {
+		t.Errorf("Contents after RotateLeft(2) = %d, want %d", got, want)
+	}
+}
+
+// TestRotateLeftOverlap is a regression test: on a non-full deque, the
+// destination range for the shorter side being moved can wrap around
+// through physical index 0 and overlap the source range it's still reading
+// from.
+func TestRotateLeftOverlap(t *testing.T) {
+	t.Parallel()
+
+	q := WithCapacity[int](8)
+	q.PushBack(207, 1, 2, 3, 4, 4, 233)
+	q.RotateLeft(2)
+	got := make([]int, q.Len())
+	for i, x := range q.All() {
+		got[i] = x
+	}
+	want := []int{2, 3, 4, 4, 233, 207, 1}
+	if !slices.Equal(got, want) {
+		t.Errorf("Contents after RotateLeft(2) = %d, want %d", got, want)
+	}
+}
+
+func TestRotateLeftOverlapBackCase(t *testing.T) {
+	t.Parallel()
+
+	q := WithCapacity[int](8)
+	q.PushBack(207, 1, 2, 3, 4, 4, 233)
+	q.RotateLeft(5)
+	got := make([]int, q.Len())
+	for i, x := range q.All() {
+		got[i] = x
+	}
+	want := []int{4, 233, 207, 1, 2, 3, 4}
+	if !slices.Equal(got, want) {
+		t.Errorf("Contents after RotateLeft(5) = %d, want %d", got, want)
+	}
+}
+
+func TestRotateRight(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		desc string
+		in   []int
+		n    int
+		want []int
+	}{{
+		desc: "Basic",
+		in:   []int{1, 2, 3, 4, 5},
+		n:    2,
+		want: []int{4, 5, 1, 2, 3},
+	}, {
+		desc: "Zero",
+		in:   []int{1, 2, 3},
+		n:    0,
+		want: []int{1, 2, 3},
+	}} {
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			q := From(tc.in)
+			q.RotateRight(tc.n)
+			got := make([]int, q.Len())
+			for i, x := range q.All() {
+				got[i] = x
+			}
+			if !slices.Equal(got, tc.want) {
+				t.Errorf("RotateRight(%d) contents = %d, want %d", tc.n, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestRetain(t *testing.T) {
+	t.Parallel()
+
+	q := From([]int{3, 4, 5})
+	q.PushFront(1, 2)
+	q.Retain(func(v int) bool { return v%2 == 0 })
+	got := make([]int, q.Len())
+	for i, x := range q.All() {
+		got[i] = x
+	}
+	want := []int{2, 4}
+	if !slices.Equal(got, want) {
+		t.Errorf("Contents after Retain = %d, want %d", got, want)
+	}
+}
+
+func TestTruncate(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		desc string
+		in   []int
+		n    int
+		want []int
+	}{{
+		desc: "Shrink",
+		in:   []int{1, 2, 3, 4, 5},
+		n:    2,
+		want: []int{1, 2},
+	}, {
+		desc: "NoOp",
+		in:   []int{1, 2, 3},
+		n:    5,
+		want: []int{1, 2, 3},
+	}} {
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			q := From(tc.in)
+			q.Truncate(tc.n)
+			got := make([]int, q.Len())
+			for i, x := range q.All() {
+				got[i] = x
+			}
+			if !slices.Equal(got, tc.want) {
+				t.Errorf("Truncate(%d) contents = %d, want %d", tc.n, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestExtend(t *testing.T) {
+	t.Parallel()
+
+	q := From([]int{1, 2, 3})
+	q.Extend(slices.Values([]int{4, 5}))
+	got := make([]int, q.Len())
+	for i, x := range q.All() {
+		got[i] = x
+	}
+	want := []int{1, 2, 3, 4, 5}
+	if !slices.Equal(got, want) {
+		t.Errorf("Contents after Extend = %d, want %d", got, want)
+	}
+}
+
+func TestAsSlices(t *testing.T) {
+	t.Parallel()
+
+	q := From([]int{3, 4, 5})
+	q.PushFront(1, 2)
+	front, back := q.AsSlices()
+	if want := []int{1, 2}; !slices.Equal(front, want) {
+		t.Errorf("AsSlices() front = %d, want %d", front, want)
+	}
+	if want := []int{3, 4, 5}; !slices.Equal(back, want) {
+		t.Errorf("AsSlices() back = %d, want %d", back, want)
+	}
+}
+
+func TestAsMutSlices(t *testing.T) {
+	t.Parallel()
+
+	q := From([]int{3, 4, 5})
+	q.PushFront(1, 2)
+	front, back := q.AsMutSlices()
+	front[0] = 10
+	back[0] = 30
+	got := make([]int, q.Len())
+	for i, x := range q.All() {
+		got[i] = x
+	}
+	want := []int{10, 2, 30, 4, 5}
+	if !slices.Equal(got, want) {
+		t.Errorf("Contents after AsMutSlices mutation = %d, want %d", got, want)
+	}
+}
+
+func TestMakeContiguous(t *testing.T) {
+	t.Parallel()
+
+	q := From([]int{3, 4, 5})
+	q.PushFront(1, 2)
+	got := q.MakeContiguous()
+	want := []int{1, 2, 3, 4, 5}
+	if !slices.Equal(got, want) {
+		t.Errorf("MakeContiguous() = %d, want %d", got, want)
+	}
+}
+
+func TestPopAll(t *testing.T) {
+	q := From([]int{1, 2, 3})
+	got := make([]int, 0, q.Len())
+	for x := range q.PopAll() {
+		got = append(got, x)
+	}
+	if got, want := q.Len(), 0; got != want {
+		t.Errorf("Len() = %d, want %d", got, want)
+	}
+	want := []int{1, 2, 3}
+	if !slices.Equal(got, want) {
+		t.Errorf("PopAll() returned values %d, want %d", got, want)
+	}
+}